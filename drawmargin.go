@@ -0,0 +1,48 @@
+package trueskill
+
+import (
+	"errors"
+	"math"
+
+	"github.com/mafredri/go-gaussian"
+)
+
+var errInvalidHistoryTotal = errors.New("total must be greater than zero")
+
+// CalcDrawMargin returns the truncate-factor epsilon for a comparison
+// between size total players, given a draw probability between zero and
+// one: drawMargin = Φ⁻¹((drawProb+1)/2) · sqrt(size) · Beta.
+func (ts Config) CalcDrawMargin(drawProb, size float64) float64 {
+	return gaussian.NormPpf((drawProb+1)/2) * math.Sqrt(size) * ts.Beta
+}
+
+// CalcDrawProbability is the inverse of CalcDrawMargin: given an observed
+// (or desired) draw margin for a comparison between size total players, it
+// returns the draw probability that would produce it.
+func (ts Config) CalcDrawProbability(margin, size float64) float64 {
+	return 2*gaussian.NormCdf(margin/(math.Sqrt(size)*ts.Beta)) - 1
+}
+
+// WithDrawMargin returns a copy of ts with DrawProb set so that a two-player
+// match produces the given draw margin. This lets callers who think in
+// terms of an absolute performance margin (rather than a percentage) derive
+// the equivalent draw probability.
+func (ts Config) WithDrawMargin(margin float64) Config {
+	ts.DrawProb = ts.CalcDrawProbability(margin, 2)
+	return ts
+}
+
+// SetDrawProbabilityFromHistory sets DrawProb from an empirically observed
+// draw rate (draws out of total matches), for callers who track real draw
+// statistics instead of guessing a percentage.
+//
+// It returns an error, leaving ts unchanged, if total is not greater than
+// zero, since that would silently poison DrawProb (and every downstream
+// CalcDrawMargin/Rate1vs1/AdjustSkills call) with NaN.
+func (ts *Config) SetDrawProbabilityFromHistory(draws, total int) error {
+	if total <= 0 {
+		return errInvalidHistoryTotal
+	}
+	ts.DrawProb = float64(draws) / float64(total)
+	return nil
+}
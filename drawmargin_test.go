@@ -0,0 +1,71 @@
+package trueskill
+
+import "testing"
+
+// TestCalcDrawProbabilityInvertsCalcDrawMargin checks that
+// CalcDrawProbability undoes CalcDrawMargin for a range of draw
+// probabilities, since the two are defined as inverses of each other.
+func TestCalcDrawProbabilityInvertsCalcDrawMargin(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	for _, drawProb := range []float64{0.01, 0.1, 0.25, 0.5, 0.9} {
+		margin := ts.CalcDrawMargin(drawProb, 2)
+		got := ts.CalcDrawProbability(margin, 2)
+		if !almostEqual(got, drawProb) {
+			t.Errorf("CalcDrawProbability(CalcDrawMargin(%v, 2), 2) = %v, want %v", drawProb, got, drawProb)
+		}
+	}
+}
+
+// TestWithDrawMarginRoundTrips checks that WithDrawMargin sets a DrawProb
+// which reproduces the requested margin for a two-player match.
+func TestWithDrawMarginRoundTrips(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	const wantMargin = 2.5
+	withMargin := ts.WithDrawMargin(wantMargin)
+
+	got := withMargin.CalcDrawMargin(withMargin.DrawProb, 2)
+	if !almostEqual(got, wantMargin) {
+		t.Errorf("CalcDrawMargin after WithDrawMargin(%v) = %v, want %v", wantMargin, got, wantMargin)
+	}
+}
+
+// TestSetDrawProbabilityFromHistory checks the empirical-rate setter
+// against a couple of simple fractions.
+func TestSetDrawProbabilityFromHistory(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	if err := ts.SetDrawProbabilityFromHistory(3, 12); err != nil {
+		t.Fatalf("SetDrawProbabilityFromHistory: %v", err)
+	}
+	if !almostEqual(ts.DrawProb, 0.25) {
+		t.Errorf("DrawProb = %v, want 0.25", ts.DrawProb)
+	}
+}
+
+// TestSetDrawProbabilityFromHistoryRejectsZeroTotal checks that an empty
+// history is rejected instead of silently poisoning DrawProb with NaN.
+func TestSetDrawProbabilityFromHistoryRejectsZeroTotal(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	before := ts.DrawProb
+	if err := ts.SetDrawProbabilityFromHistory(0, 0); err == nil {
+		t.Fatalf("SetDrawProbabilityFromHistory(0, 0) error = nil, want non-nil")
+	}
+	if ts.DrawProb != before {
+		t.Errorf("DrawProb = %v after a rejected call, want unchanged %v", ts.DrawProb, before)
+	}
+}
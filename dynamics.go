@@ -0,0 +1,55 @@
+package trueskill
+
+import (
+	"math"
+	"time"
+
+	"github.com/mafredri/go-gaussian"
+)
+
+// DynamicsTimeStep is the unit of elapsed time that ApplyDynamics measures
+// inactivity in: one full step adds tau^2 to sigma^2. Matchmaking
+// deployments typically apply dynamics once per day of inactivity.
+const DynamicsTimeStep = 24 * time.Hour
+
+// ApplyDynamics widens a player's sigma to account for skill drift over
+// elapsed time since their last known rating, using the configured Tau:
+// sigma^2 += steps*tau^2, where steps is elapsed divided by DynamicsTimeStep.
+// steps is not rounded, so a partial step adds the same fraction of tau^2.
+// Mu is left unchanged.
+func (ts Config) ApplyDynamics(p Player, elapsed time.Duration) Player {
+	steps := elapsed.Seconds() / DynamicsTimeStep.Seconds()
+	if steps <= 0 {
+		return p
+	}
+
+	sigma2 := p.Sigma()*p.Sigma() + steps*ts.Tau*ts.Tau
+	return Player{Gaussian: gaussian.NewFromMeanAndVariance(p.Mu(), sigma2)}
+}
+
+// ExposeConservative returns a conservative point estimate of a player's
+// skill: a value they are unlikely to be rated below. The maximum is two
+// times Mu, in the default configuration this is a value between zero and
+// fifty.
+func (ts Config) ExposeConservative(p Player) float64 {
+	conservative := p.Mu() - p.Sigma()*3
+	return math.Min(ts.Mu*2, math.Max(0, conservative))
+}
+
+// ExposeAggressive returns an optimistic point estimate of a player's
+// skill: a value they are unlikely to be rated above. It is the mirror of
+// ExposeConservative, useful for matchmaking that wants to seed a player's
+// rank from their upside rather than their floor.
+func (ts Config) ExposeAggressive(p Player) float64 {
+	aggressive := p.Mu() + p.Sigma()*3
+	return math.Max(0, aggressive)
+}
+
+// TrueSkill returns the conservative TrueSkill of a player. The maximum
+// TrueSkill is two times mu, in the default configuration a value between
+// zero and fifty is returned.
+//
+// Deprecated: use ExposeConservative, which this now wraps.
+func (ts Config) TrueSkill(p Player) float64 {
+	return ts.ExposeConservative(p)
+}
@@ -0,0 +1,56 @@
+package trueskill
+
+import "testing"
+
+// TestApplyDynamicsInflatesSigmaOverTime checks that ApplyDynamics widens
+// sigma in proportion to elapsed DynamicsTimeSteps and leaves mu untouched,
+// and that it is a no-op for non-positive elapsed time.
+func TestApplyDynamicsInflatesSigmaOverTime(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	p := NewPlayer(25, 5)
+
+	same := ts.ApplyDynamics(p, 0)
+	if same.Mu() != p.Mu() || same.Sigma() != p.Sigma() {
+		t.Errorf("ApplyDynamics with zero elapsed = %+v, want unchanged %+v", same, p)
+	}
+
+	decayed := ts.ApplyDynamics(p, DynamicsTimeStep)
+	if decayed.Mu() != p.Mu() {
+		t.Errorf("decayed.Mu() = %v, want unchanged %v", decayed.Mu(), p.Mu())
+	}
+	if decayed.Sigma() <= p.Sigma() {
+		t.Errorf("decayed.Sigma() = %v, want greater than starting sigma %v", decayed.Sigma(), p.Sigma())
+	}
+
+	wantSigma2 := p.Sigma()*p.Sigma() + ts.Tau*ts.Tau
+	if gotSigma2 := decayed.Sigma() * decayed.Sigma(); !almostEqual(gotSigma2, wantSigma2) {
+		t.Errorf("decayed sigma^2 = %v, want %v", gotSigma2, wantSigma2)
+	}
+}
+
+// TestExposeConservativeAndAggressiveBracketMu checks that the two exposed
+// point estimates sit on either side of a player's mean, the ordering the
+// TrueSkill method and matchmaking both rely on.
+func TestExposeConservativeAndAggressiveBracketMu(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	p := NewPlayer(25, 5)
+
+	conservative := ts.ExposeConservative(p)
+	aggressive := ts.ExposeAggressive(p)
+
+	if !(conservative <= p.Mu() && p.Mu() <= aggressive) {
+		t.Errorf("ExposeConservative=%v, Mu=%v, ExposeAggressive=%v; want conservative <= mu <= aggressive", conservative, p.Mu(), aggressive)
+	}
+
+	if got := ts.TrueSkill(p); got != conservative {
+		t.Errorf("TrueSkill(p) = %v, want %v (ExposeConservative)", got, conservative)
+	}
+}
@@ -0,0 +1,209 @@
+// Package matrix provides the small amount of dense-matrix arithmetic
+// needed by the multi-team match quality calculation: determinant and
+// inverse via LU decomposition, plus enough general multiplication to build
+// the contrast-matrix products used there. It is not a general-purpose
+// linear algebra library and is kept internal to go-trueskill on purpose.
+package matrix
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrSingular is returned by Det and Inverse when the matrix has no LU
+// decomposition (a pivot column is entirely zero).
+var ErrSingular = errors.New("matrix: singular matrix")
+
+// ErrNotSquare is returned by Det and Inverse when called on a non-square
+// matrix.
+var ErrNotSquare = errors.New("matrix: not square")
+
+// Dense is a row-major, possibly non-square dense matrix.
+type Dense struct {
+	rows, cols int
+	data       []float64
+}
+
+// NewDense creates a rows x cols matrix from row-major data. len(data) must
+// equal rows*cols.
+func NewDense(rows, cols int, data []float64) *Dense {
+	return &Dense{rows: rows, cols: cols, data: data}
+}
+
+// Zeros creates a rows x cols matrix of zeros.
+func Zeros(rows, cols int) *Dense {
+	return &Dense{rows: rows, cols: cols, data: make([]float64, rows*cols)}
+}
+
+// Identity creates the n x n identity matrix.
+func Identity(n int) *Dense {
+	m := Zeros(n, n)
+	for i := 0; i < n; i++ {
+		m.Set(i, i, 1)
+	}
+	return m
+}
+
+// Diag creates an n x n diagonal matrix from the given values.
+func Diag(values []float64) *Dense {
+	m := Zeros(len(values), len(values))
+	for i, v := range values {
+		m.Set(i, i, v)
+	}
+	return m
+}
+
+// At returns the value at row i, column j.
+func (m *Dense) At(i, j int) float64 {
+	return m.data[i*m.cols+j]
+}
+
+// Set assigns the value at row i, column j.
+func (m *Dense) Set(i, j int, v float64) {
+	m.data[i*m.cols+j] = v
+}
+
+// Dims returns the row and column count.
+func (m *Dense) Dims() (rows, cols int) {
+	return m.rows, m.cols
+}
+
+// Mul returns the matrix product m * other.
+func (m *Dense) Mul(other *Dense) *Dense {
+	result := Zeros(m.rows, other.cols)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < other.cols; j++ {
+			var sum float64
+			for k := 0; k < m.cols; k++ {
+				sum += m.At(i, k) * other.At(k, j)
+			}
+			result.Set(i, j, sum)
+		}
+	}
+	return result
+}
+
+// Add returns the matrix sum m + other.
+func (m *Dense) Add(other *Dense) *Dense {
+	result := Zeros(m.rows, m.cols)
+	for i, v := range m.data {
+		result.data[i] = v + other.data[i]
+	}
+	return result
+}
+
+// Transpose returns the transpose of m.
+func (m *Dense) Transpose() *Dense {
+	result := Zeros(m.cols, m.rows)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.cols; j++ {
+			result.Set(j, i, m.At(i, j))
+		}
+	}
+	return result
+}
+
+// luDecompose performs an LU decomposition with partial pivoting, returning
+// the combined LU matrix, the row permutation, and the sign of the
+// permutation (used when computing the determinant).
+func (m *Dense) luDecompose() (lu *Dense, perm []int, sign float64, err error) {
+	if m.rows != m.cols {
+		return nil, nil, 0, ErrNotSquare
+	}
+
+	n := m.rows
+	lu = Zeros(n, n)
+	copy(lu.data, m.data)
+
+	perm = make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	sign = 1
+
+	for k := 0; k < n; k++ {
+		pivotRow, pivotVal := k, math.Abs(lu.At(k, k))
+		for i := k + 1; i < n; i++ {
+			if v := math.Abs(lu.At(i, k)); v > pivotVal {
+				pivotRow, pivotVal = i, v
+			}
+		}
+
+		if pivotVal == 0 {
+			return nil, nil, 0, ErrSingular
+		}
+
+		if pivotRow != k {
+			for j := 0; j < n; j++ {
+				lu.data[k*n+j], lu.data[pivotRow*n+j] = lu.data[pivotRow*n+j], lu.data[k*n+j]
+			}
+			perm[k], perm[pivotRow] = perm[pivotRow], perm[k]
+			sign = -sign
+		}
+
+		for i := k + 1; i < n; i++ {
+			factor := lu.At(i, k) / lu.At(k, k)
+			lu.Set(i, k, factor)
+			for j := k + 1; j < n; j++ {
+				lu.Set(i, j, lu.At(i, j)-factor*lu.At(k, j))
+			}
+		}
+	}
+
+	return lu, perm, sign, nil
+}
+
+// Det returns the determinant of m.
+func (m *Dense) Det() (float64, error) {
+	lu, _, sign, err := m.luDecompose()
+	if err != nil {
+		return 0, err
+	}
+
+	det := sign
+	for i := 0; i < m.rows; i++ {
+		det *= lu.At(i, i)
+	}
+	return det, nil
+}
+
+// Inverse returns the inverse of m.
+func (m *Dense) Inverse() (*Dense, error) {
+	n := m.rows
+	lu, perm, _, err := m.luDecompose()
+	if err != nil {
+		return nil, err
+	}
+
+	inv := Zeros(n, n)
+	for col := 0; col < n; col++ {
+		b := make([]float64, n)
+		b[col] = 1
+
+		// Apply the row permutation to the identity column, then solve
+		// Ly = Pb followed by Ux = y.
+		y := make([]float64, n)
+		for i := 0; i < n; i++ {
+			sum := b[perm[i]]
+			for j := 0; j < i; j++ {
+				sum -= lu.At(i, j) * y[j]
+			}
+			y[i] = sum
+		}
+
+		x := make([]float64, n)
+		for i := n - 1; i >= 0; i-- {
+			sum := y[i]
+			for j := i + 1; j < n; j++ {
+				sum -= lu.At(i, j) * x[j]
+			}
+			x[i] = sum / lu.At(i, i)
+		}
+
+		for row := 0; row < n; row++ {
+			inv.Set(row, col, x[row])
+		}
+	}
+
+	return inv, nil
+}
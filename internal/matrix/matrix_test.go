@@ -0,0 +1,156 @@
+package matrix
+
+import (
+	"math"
+	"testing"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestIdentityDet(t *testing.T) {
+	det, err := Identity(3).Det()
+	if err != nil {
+		t.Fatalf("Det: %v", err)
+	}
+	if !almostEqual(det, 1) {
+		t.Errorf("Det(Identity(3)) = %v, want 1", det)
+	}
+}
+
+func TestIdentityInverse(t *testing.T) {
+	inv, err := Identity(3).Inverse()
+	if err != nil {
+		t.Fatalf("Inverse: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if got := inv.At(i, j); !almostEqual(got, want) {
+				t.Errorf("Inverse(Identity(3))[%d][%d] = %v, want %v", i, j, got, want)
+			}
+		}
+	}
+}
+
+func TestDet2x2(t *testing.T) {
+	// | 4 3 |
+	// | 6 3 |  => det = 4*3 - 3*6 = -6
+	m := NewDense(2, 2, []float64{4, 3, 6, 3})
+	det, err := m.Det()
+	if err != nil {
+		t.Fatalf("Det: %v", err)
+	}
+	if !almostEqual(det, -6) {
+		t.Errorf("Det = %v, want -6", det)
+	}
+}
+
+func TestInverse2x2(t *testing.T) {
+	// | 4 3 |^-1 = 1/-6 * | 3 -3 | = | -0.5  0.5 |
+	// | 6 3 |             |-6  4 |   |  1   -0.6667|
+	m := NewDense(2, 2, []float64{4, 3, 6, 3})
+	inv, err := m.Inverse()
+	if err != nil {
+		t.Fatalf("Inverse: %v", err)
+	}
+
+	want := []float64{-0.5, 0.5, 1, -2.0 / 3.0}
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			if got, w := inv.At(i, j), want[i*2+j]; !almostEqual(got, w) {
+				t.Errorf("Inverse[%d][%d] = %v, want %v", i, j, got, w)
+			}
+		}
+	}
+
+	// m * inv should be the identity.
+	prod := m.Mul(inv)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if got := prod.At(i, j); !almostEqual(got, want) {
+				t.Errorf("(m * Inverse(m))[%d][%d] = %v, want %v", i, j, got, want)
+			}
+		}
+	}
+}
+
+func TestDet3x3(t *testing.T) {
+	// | 6 1 1 |
+	// | 4 -2 5 |  => det = 6*(-2*7 - 5*8) - 1*(4*7 - 5*2) + 1*(4*8 - -2*2) = -306
+	// | 2 8 7 |
+	m := NewDense(3, 3, []float64{
+		6, 1, 1,
+		4, -2, 5,
+		2, 8, 7,
+	})
+	det, err := m.Det()
+	if err != nil {
+		t.Fatalf("Det: %v", err)
+	}
+	if !almostEqual(det, -306) {
+		t.Errorf("Det = %v, want -306", det)
+	}
+}
+
+func TestInverse3x3RoundTrips(t *testing.T) {
+	m := NewDense(3, 3, []float64{
+		6, 1, 1,
+		4, -2, 5,
+		2, 8, 7,
+	})
+	inv, err := m.Inverse()
+	if err != nil {
+		t.Fatalf("Inverse: %v", err)
+	}
+
+	prod := m.Mul(inv)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if got := prod.At(i, j); !almostEqual(got, want) {
+				t.Errorf("(m * Inverse(m))[%d][%d] = %v, want %v", i, j, got, want)
+			}
+		}
+	}
+}
+
+func TestDetSingularMatrix(t *testing.T) {
+	// Rows are linearly dependent (row 2 = 2 * row 1).
+	m := NewDense(2, 2, []float64{1, 2, 2, 4})
+	if _, err := m.Det(); err != ErrSingular {
+		t.Errorf("Det(singular) error = %v, want ErrSingular", err)
+	}
+}
+
+func TestInverseSingularMatrix(t *testing.T) {
+	m := NewDense(2, 2, []float64{1, 2, 2, 4})
+	if _, err := m.Inverse(); err != ErrSingular {
+		t.Errorf("Inverse(singular) error = %v, want ErrSingular", err)
+	}
+}
+
+func TestDetNonSquareMatrix(t *testing.T) {
+	m := NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6})
+	if _, err := m.Det(); err != ErrNotSquare {
+		t.Errorf("Det(non-square) error = %v, want ErrNotSquare", err)
+	}
+}
+
+func TestInverseNonSquareMatrix(t *testing.T) {
+	m := NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6})
+	if _, err := m.Inverse(); err != ErrNotSquare {
+		t.Errorf("Inverse(non-square) error = %v, want ErrNotSquare", err)
+	}
+}
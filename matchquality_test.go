@@ -0,0 +1,57 @@
+package trueskill
+
+import "testing"
+
+// TestMatchQualityTeamsGeneralReducesToTwoPlayer exercises the general
+// contrast-matrix path directly (bypassing the k=2 singleton fast path
+// that MatchQualityTeams takes) to prove it agrees with the closed-form
+// two-player formula it is supposed to generalize.
+func TestMatchQualityTeamsGeneralReducesToTwoPlayer(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	a := NewPlayer(30, 5)
+	b := NewPlayer(25, 7)
+
+	want := calculate2PlayerMatchQuality(ts, a, b)
+	got := matchQualityTeamsGeneral(ts, []Players{{a}, {b}})
+
+	if !almostEqual(got, want) {
+		t.Errorf("matchQualityTeamsGeneral = %v, want %v (calculate2PlayerMatchQuality)", got, want)
+	}
+}
+
+// TestMatchQualityTeamsMultiTeam checks the bounds and ordering of
+// MatchQualityTeams for a three-team match with a multi-player team, using
+// the same Sigma = diag(sigma_i^2 + m_i*beta^2) formulation as the
+// implementation.
+func TestMatchQualityTeamsMultiTeam(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	teamA := Players{NewPlayer(25, 8.333), NewPlayer(25, 8.333)}
+	teamB := Players{NewPlayer(25, 8.333)}
+	teamC := Players{NewPlayer(25, 8.333)}
+
+	got := ts.MatchQualityTeams([]Players{teamA, teamB, teamC})
+
+	// The two-player team's combined performance (mu=50,
+	// var=2*sigma^2+2*beta^2) outweighs each single's (mu=25,
+	// var=sigma^2+beta^2), so the A-B margin pulls quality down while B-C
+	// is even. The value must be a valid probability-like quality strictly
+	// between 0 and 1, and A's pairing with the singles must be worse than
+	// the two identical singles pairing with each other (teamB vs teamC
+	// alone).
+	if got <= 0 || got >= 1 {
+		t.Fatalf("MatchQualityTeams = %v, want value in (0, 1)", got)
+	}
+
+	soloQuality := ts.MatchQualityTeams([]Players{teamB, teamC})
+	if !(got < soloQuality) {
+		t.Errorf("MatchQualityTeams(A,B,C) = %v, want less than MatchQualityTeams(B,C) = %v (A outweighs a single)", got, soloQuality)
+	}
+}
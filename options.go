@@ -0,0 +1,105 @@
+package trueskill
+
+import "math"
+
+// AdjustOptions carries the optional, per-match extensions to team skill
+// adjustment: partial-play weights and score-based draw margins.
+type AdjustOptions struct {
+	// Weights holds one partial-play weight per player, grouped by team in
+	// the same order as the teams passed to AdjustSkillsWithOptions. A
+	// weight scales how much a player's performance contributes to their
+	// team's performance sum, for players who joined late or sat out part
+	// of the match. Weights are typically in [0, 1]; a nil entry, or a nil
+	// Weights slice entirely, defaults every player on that team to 1.
+	Weights [][]float64
+
+	// Scores holds one raw score per team, in the same order as teams. When
+	// set, the margin between adjacent teams (after sorting by rank) is
+	// scaled by how close their scores were, biasing the truncate factor
+	// towards a continuous outcome rather than a pure win/draw/loss. Scores
+	// is ignored if its length does not match teams.
+	Scores []float64
+}
+
+// AdjustSkillsWithOptions is the full form of AdjustTeamSkills, additionally
+// accepting partial-play weights and score-based draw margins. See
+// AdjustOptions for details.
+func (ts Config) AdjustSkillsWithOptions(teams []Team, ranks []int, opts AdjustOptions) ([]Team, float64) {
+	if len(teams) != len(ranks) || len(teams) == 0 {
+		return nil, 0
+	}
+
+	order := sortTeamsByRank(ranks)
+
+	draws := make([]bool, len(teams)-1)
+	for i := 0; i < len(order)-1; i++ {
+		draws[i] = ranks[order[i]] == ranks[order[i+1]]
+	}
+
+	sortedTeams := make([]Team, len(teams))
+	weights := make([][]float64, len(teams))
+	for i, idx := range order {
+		sortedTeams[i] = teams[idx]
+		weights[i] = teamWeight(teams[idx], opts.Weights, idx)
+	}
+
+	var scores []float64
+	if len(opts.Scores) == len(teams) {
+		scores = make([]float64, len(teams))
+		for i, idx := range order {
+			scores[i] = opts.Scores[idx]
+		}
+	}
+
+	updated, probability := adjustTeamSkills(ts, sortedTeams, draws, weights, scores)
+
+	// Restore the caller's original team ordering.
+	result := make([]Team, len(teams))
+	for i, idx := range order {
+		result[idx] = updated[i]
+	}
+
+	return result, probability
+}
+
+// teamWeight returns the partial-play weights to use for the team at idx,
+// falling back to a weight of 1 per player when opts.Weights doesn't cover
+// that team or player.
+func teamWeight(team Team, optWeights [][]float64, idx int) []float64 {
+	w := make([]float64, len(team))
+	for i := range w {
+		w[i] = 1
+	}
+
+	if idx >= len(optWeights) {
+		return w
+	}
+
+	for i, given := range optWeights[idx] {
+		if i >= len(w) {
+			break
+		}
+		w[i] = given
+	}
+
+	return w
+}
+
+// scoreMarginScale returns a factor in [0, 1] that shrinks the draw margin
+// between two teams as their scores pull apart: a blowout (large relative
+// score gap) narrows the margin towards a decisive win, while a close score
+// keeps the margin close to the configured default. It is 0 when one score
+// is zero and the other is not, or when the scores are opposites.
+func scoreMarginScale(a, b float64) float64 {
+	denom := math.Abs(a) + math.Abs(b)
+	if denom == 0 {
+		return 1
+	}
+
+	gap := math.Abs(a-b) / denom
+	if gap > 1 {
+		gap = 1
+	}
+
+	return 1 - gap
+}
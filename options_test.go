@@ -0,0 +1,69 @@
+package trueskill
+
+import "testing"
+
+// TestAdjustSkillsWithOptionsPartialPlayWeight checks that a lower
+// partial-play weight shrinks a player's share of the rating adjustment
+// relative to a full-weight teammate in the same match.
+func TestAdjustSkillsWithOptionsPartialPlayWeight(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	full := ts.NewDefaultPlayer()
+	partial := ts.NewDefaultPlayer()
+	opponent := ts.NewDefaultPlayer()
+
+	teams := []Team{{full, partial}, {opponent}}
+	ranks := []int{0, 1}
+
+	updated, _ := ts.AdjustSkillsWithOptions(teams, ranks, AdjustOptions{
+		Weights: [][]float64{{1, 0.25}},
+	})
+
+	fullGain := updated[0][0].Mu() - full.Mu()
+	partialGain := updated[0][1].Mu() - partial.Mu()
+
+	if !(partialGain > 0 && partialGain < fullGain) {
+		t.Errorf("partialGain = %v, fullGain = %v; want 0 < partialGain < fullGain", partialGain, fullGain)
+	}
+}
+
+// TestAdjustSkillsWithOptionsScoreNarrowsBlowoutMargin checks that a
+// lopsided Scores pair narrows the draw margin (and so the rating swing)
+// relative to an otherwise identical match with no scores at all, per
+// scoreMarginScale.
+func TestAdjustSkillsWithOptionsScoreNarrowsBlowoutMargin(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	teams := []Team{{ts.NewDefaultPlayer()}, {ts.NewDefaultPlayer()}}
+	ranks := []int{0, 1}
+
+	plain, _ := ts.AdjustSkillsWithOptions(teams, ranks, AdjustOptions{})
+	blowout, _ := ts.AdjustSkillsWithOptions(teams, ranks, AdjustOptions{
+		Scores: []float64{100, 1},
+	})
+
+	plainGain := plain[0][0].Mu() - teams[0][0].Mu()
+	blowoutGain := blowout[0][0].Mu() - teams[0][0].Mu()
+
+	if !(blowoutGain < plainGain) {
+		t.Errorf("blowoutGain = %v, want less than plainGain = %v", blowoutGain, plainGain)
+	}
+}
+
+func TestScoreMarginScale(t *testing.T) {
+	if got := scoreMarginScale(0, 0); got != 1 {
+		t.Errorf("scoreMarginScale(0, 0) = %v, want 1", got)
+	}
+	if got := scoreMarginScale(10, 10); !almostEqual(got, 1) {
+		t.Errorf("scoreMarginScale(10, 10) = %v, want 1 (tied scores keep the full margin)", got)
+	}
+	if got := scoreMarginScale(100, 1); got >= 0.5 {
+		t.Errorf("scoreMarginScale(100, 1) = %v, want a narrow margin for a blowout", got)
+	}
+}
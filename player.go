@@ -0,0 +1,42 @@
+package trueskill
+
+import "github.com/mafredri/go-gaussian"
+
+// Player represents a single participant's skill belief as a Gaussian.
+type Player struct {
+	Gaussian gaussian.Gaussian
+}
+
+// NewPlayer creates a new player with the given mean and standard deviation.
+func NewPlayer(mu, sigma float64) Player {
+	return Player{Gaussian: gaussian.NewFromMeanAndStdDev(mu, sigma)}
+}
+
+// Mu returns the player's mean skill estimate.
+func (p Player) Mu() float64 {
+	return p.Gaussian.Mean()
+}
+
+// Sigma returns the player's skill standard deviation.
+func (p Player) Sigma() float64 {
+	return p.Gaussian.StdDev()
+}
+
+// Players is an ordered collection of Player, sortable by skill.
+type Players []Player
+
+// Len implements sort.Interface.
+func (p Players) Len() int {
+	return len(p)
+}
+
+// Less implements sort.Interface, ordering players by descending mean
+// skill.
+func (p Players) Less(i, j int) bool {
+	return p[i].Mu() > p[j].Mu()
+}
+
+// Swap implements sort.Interface.
+func (p Players) Swap(i, j int) {
+	p[i], p[j] = p[j], p[i]
+}
@@ -0,0 +1,15 @@
+package trueskill
+
+import "math"
+
+// calculate2PlayerMatchQuality returns the quality of a match-up between
+// exactly two players: the closed-form, two-player specialization of the
+// draw-likelihood equation used by MatchQuality, Quality1vs1, and as the
+// fast path in MatchQualityTeams.
+func calculate2PlayerMatchQuality(ts Config, a, b Player) float64 {
+	beta2 := ts.Beta * ts.Beta
+	denom := 2*beta2 + a.Sigma()*a.Sigma() + b.Sigma()*b.Sigma()
+
+	deltaMu := a.Mu() - b.Mu()
+	return math.Sqrt(2*beta2/denom) * math.Exp(-(deltaMu*deltaMu)/(2*denom))
+}
@@ -0,0 +1,48 @@
+package trueskill
+
+import (
+	"math"
+
+	"github.com/mafredri/go-gaussian"
+)
+
+// Rate1vs1 returns the updated skills for a one-on-one match between a and
+// b, using the closed-form v/w truncation update directly instead of
+// constructing and running a full factor graph. This is the dominant use
+// case for most games and is roughly an order of magnitude faster than
+// AdjustSkills for it.
+func (ts Config) Rate1vs1(a, b Player, draw bool) (Player, Player) {
+	beta2 := ts.Beta * ts.Beta
+	c := math.Sqrt(2*beta2 + a.Sigma()*a.Sigma() + b.Sigma()*b.Sigma())
+	t := (a.Mu() - b.Mu()) / c
+	margin := ts.CalcDrawMargin(ts.DrawProb, 2) / c
+
+	var v, w float64
+	if draw {
+		v = vDraw(t, margin)
+		w = wDraw(t, margin)
+	} else {
+		v = vWin(t, margin)
+		w = wWin(t, margin)
+	}
+
+	aV, bV := a.Sigma()*a.Sigma(), b.Sigma()*b.Sigma()
+
+	newA := Player{Gaussian: gaussian.NewFromMeanAndVariance(
+		a.Mu()+(aV/c)*v,
+		aV*(1-(aV/(c*c))*w),
+	)}
+	newB := Player{Gaussian: gaussian.NewFromMeanAndVariance(
+		b.Mu()-(bV/c)*v,
+		bV*(1-(bV/(c*c))*w),
+	)}
+
+	return newA, newB
+}
+
+// Quality1vs1 returns the match quality for a one-on-one match between a
+// and b. It is equivalent to MatchQuality for two single-player teams, kept
+// as a convenience alongside Rate1vs1.
+func (ts Config) Quality1vs1(a, b Player) float64 {
+	return calculate2PlayerMatchQuality(ts, a, b)
+}
@@ -0,0 +1,76 @@
+package trueskill
+
+import "testing"
+
+// TestRate1vs1WinnerGainsLoserDrops checks the basic shape of a decisive
+// 1v1 outcome: the winner's mean should rise, the loser's should fall, and
+// both players' uncertainty should shrink from learning the match result.
+func TestRate1vs1WinnerGainsLoserDrops(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	winner := ts.NewDefaultPlayer()
+	loser := ts.NewDefaultPlayer()
+
+	newWinner, newLoser := ts.Rate1vs1(winner, loser, false)
+
+	if newWinner.Mu() <= winner.Mu() {
+		t.Errorf("winner mu = %v, want greater than starting mu %v", newWinner.Mu(), winner.Mu())
+	}
+	if newLoser.Mu() >= loser.Mu() {
+		t.Errorf("loser mu = %v, want less than starting mu %v", newLoser.Mu(), loser.Mu())
+	}
+	if newWinner.Sigma() >= winner.Sigma() {
+		t.Errorf("winner sigma = %v, want less than starting sigma %v", newWinner.Sigma(), winner.Sigma())
+	}
+	if newLoser.Sigma() >= loser.Sigma() {
+		t.Errorf("loser sigma = %v, want less than starting sigma %v", newLoser.Sigma(), loser.Sigma())
+	}
+}
+
+// TestRate1vs1DrawIsSymmetric checks that a draw between two equally rated
+// players leaves their means unchanged, since neither has any information
+// favoring one over the other.
+func TestRate1vs1DrawIsSymmetric(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	a := ts.NewDefaultPlayer()
+	b := ts.NewDefaultPlayer()
+
+	newA, newB := ts.Rate1vs1(a, b, true)
+
+	if !almostEqual(newA.Mu(), a.Mu()) {
+		t.Errorf("a.Mu() = %v after a symmetric draw, want unchanged %v", newA.Mu(), a.Mu())
+	}
+	if !almostEqual(newB.Mu(), b.Mu()) {
+		t.Errorf("b.Mu() = %v after a symmetric draw, want unchanged %v", newB.Mu(), b.Mu())
+	}
+	if newA.Sigma() >= a.Sigma() {
+		t.Errorf("a.Sigma() = %v, want less than starting sigma %v", newA.Sigma(), a.Sigma())
+	}
+}
+
+// TestQuality1vs1MatchesMatchQuality checks that Quality1vs1 is consistent
+// with the older two-player MatchQuality it is meant to be a convenience
+// wrapper alongside.
+func TestQuality1vs1MatchesMatchQuality(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	a := NewPlayer(28, 6)
+	b := NewPlayer(22, 9)
+
+	want := ts.MatchQuality(Players{a, b})
+	got := ts.Quality1vs1(a, b)
+
+	if !almostEqual(got, want) {
+		t.Errorf("Quality1vs1 = %v, want %v (MatchQuality)", got, want)
+	}
+}
@@ -0,0 +1,134 @@
+// Package store provides a persistent, concurrency-safe rating store for
+// go-trueskill, so a matchmaking backend can keep per-player ratings across
+// sessions instead of recomputing them from scratch every process restart.
+package store
+
+import (
+	"encoding/gob"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	trueskill "github.com/mafredri/go-trueskill"
+)
+
+// RatingStore persists per-player ratings, keyed by an arbitrary ID. It is
+// safe for concurrent use.
+type RatingStore struct {
+	mu      sync.RWMutex
+	records map[string]record
+}
+
+type record struct {
+	player    trueskill.Player
+	updatedAt time.Time
+}
+
+// New creates an empty RatingStore.
+func New() *RatingStore {
+	return &RatingStore{records: make(map[string]record)}
+}
+
+// Set stores (or replaces) the rating for id, stamping it with the current
+// time so a later DecodeJSON or DecodeGob can apply dynamics decay for the
+// right duration.
+func (s *RatingStore) Set(id string, p trueskill.Player) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[id] = record{player: p, updatedAt: time.Now()}
+}
+
+// Get returns the stored rating for id, if any.
+func (s *RatingStore) Get(id string) (trueskill.Player, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	r, ok := s.records[id]
+	return r.player, ok
+}
+
+// Delete removes the rating for id.
+func (s *RatingStore) Delete(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, id)
+}
+
+// IDs returns every id currently in the store, in no particular order.
+func (s *RatingStore) IDs() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ids := make([]string, 0, len(s.records))
+	for id := range s.records {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// wireRecord is the serialized form of a rating: a Player's Gaussian keeps
+// its parameters in unexported fields, so both codecs round-trip through
+// Mu/Sigma in this struct instead.
+type wireRecord struct {
+	Mu        float64   `json:"mu"`
+	Sigma     float64   `json:"sigma"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (s *RatingStore) toWire() map[string]wireRecord {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	wire := make(map[string]wireRecord, len(s.records))
+	for id, r := range s.records {
+		wire[id] = wireRecord{Mu: r.player.Mu(), Sigma: r.player.Sigma(), UpdatedAt: r.updatedAt}
+	}
+	return wire
+}
+
+// fromWire replaces the store's contents, applying dynamics decay for the
+// time elapsed since each rating was saved.
+func (s *RatingStore) fromWire(ts trueskill.Config, wire map[string]wireRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records := make(map[string]record, len(wire))
+	for id, w := range wire {
+		p := ts.ApplyDynamics(trueskill.NewPlayer(w.Mu, w.Sigma), time.Since(w.UpdatedAt))
+		records[id] = record{player: p, updatedAt: time.Now()}
+	}
+	s.records = records
+}
+
+// EncodeJSON writes every rating in the store to w as JSON.
+func (s *RatingStore) EncodeJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(s.toWire())
+}
+
+// DecodeJSON replaces the store's contents with a stream written by
+// EncodeJSON, applying dynamics decay for the time elapsed since each
+// rating was saved under ts.
+func (s *RatingStore) DecodeJSON(ts trueskill.Config, r io.Reader) error {
+	var wire map[string]wireRecord
+	if err := json.NewDecoder(r).Decode(&wire); err != nil {
+		return err
+	}
+	s.fromWire(ts, wire)
+	return nil
+}
+
+// EncodeGob writes every rating in the store to w using encoding/gob.
+func (s *RatingStore) EncodeGob(w io.Writer) error {
+	return gob.NewEncoder(w).Encode(s.toWire())
+}
+
+// DecodeGob replaces the store's contents with a stream written by
+// EncodeGob, applying dynamics decay for the time elapsed since each
+// rating was saved under ts.
+func (s *RatingStore) DecodeGob(ts trueskill.Config, r io.Reader) error {
+	var wire map[string]wireRecord
+	if err := gob.NewDecoder(r).Decode(&wire); err != nil {
+		return err
+	}
+	s.fromWire(ts, wire)
+	return nil
+}
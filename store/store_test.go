@@ -0,0 +1,96 @@
+package store
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	trueskill "github.com/mafredri/go-trueskill"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestRatingStoreSetGetDelete(t *testing.T) {
+	s := New()
+
+	p := trueskill.NewPlayer(30, 5)
+	s.Set("alice", p)
+
+	got, ok := s.Get("alice")
+	if !ok {
+		t.Fatalf("Get(alice) not found after Set")
+	}
+	if got.Mu() != p.Mu() || got.Sigma() != p.Sigma() {
+		t.Errorf("Get(alice) = %+v, want %+v", got, p)
+	}
+
+	if ids := s.IDs(); len(ids) != 1 || ids[0] != "alice" {
+		t.Errorf("IDs() = %v, want [alice]", ids)
+	}
+
+	s.Delete("alice")
+	if _, ok := s.Get("alice"); ok {
+		t.Errorf("Get(alice) found after Delete")
+	}
+}
+
+func TestRatingStoreJSONRoundTrip(t *testing.T) {
+	ts, err := trueskill.NewDefault(trueskill.DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	s := New()
+	s.Set("bob", trueskill.NewPlayer(28, 4))
+
+	var buf bytes.Buffer
+	if err := s.EncodeJSON(&buf); err != nil {
+		t.Fatalf("EncodeJSON: %v", err)
+	}
+
+	loaded := New()
+	if err := loaded.DecodeJSON(ts, &buf); err != nil {
+		t.Fatalf("DecodeJSON: %v", err)
+	}
+
+	got, ok := loaded.Get("bob")
+	if !ok {
+		t.Fatalf("Get(bob) not found after DecodeJSON")
+	}
+	if !almostEqual(got.Mu(), 28) {
+		t.Errorf("Mu() = %v, want 28 (no elapsed time, no dynamics decay)", got.Mu())
+	}
+	if !almostEqual(got.Sigma(), 4) {
+		t.Errorf("Sigma() = %v, want 4 (no elapsed time, no dynamics decay)", got.Sigma())
+	}
+}
+
+func TestRatingStoreGobRoundTrip(t *testing.T) {
+	ts, err := trueskill.NewDefault(trueskill.DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	s := New()
+	s.Set("carol", trueskill.NewPlayer(22, 6))
+
+	var buf bytes.Buffer
+	if err := s.EncodeGob(&buf); err != nil {
+		t.Fatalf("EncodeGob: %v", err)
+	}
+
+	loaded := New()
+	if err := loaded.DecodeGob(ts, &buf); err != nil {
+		t.Fatalf("DecodeGob: %v", err)
+	}
+
+	got, ok := loaded.Get("carol")
+	if !ok {
+		t.Fatalf("Get(carol) not found after DecodeGob")
+	}
+	if !almostEqual(got.Mu(), 22) || !almostEqual(got.Sigma(), 6) {
+		t.Errorf("Get(carol) = %+v, want mu=22 sigma=6", got)
+	}
+}
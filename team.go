@@ -0,0 +1,250 @@
+package trueskill
+
+import (
+	"math"
+
+	"github.com/mafredri/go-gaussian"
+)
+
+// Team is a group of players competing together against other teams.
+type Team = Players
+
+const teamSkillMaxIterations = 100
+
+// AdjustTeamSkills returns the new skill level distributions for all
+// provided teams, grouped by team in the same order they were given, based
+// on game configuration and the supplied ranks.
+//
+// ranks must have the same length as teams. Lower rank values mean a better
+// placement (rank 0 beat rank 1), and equal ranks between two teams are
+// treated as a draw between them. Teams may be of any size.
+func (ts Config) AdjustTeamSkills(teams []Team, ranks []int) ([]Team, float64) {
+	return ts.AdjustSkillsWithOptions(teams, ranks, AdjustOptions{})
+}
+
+// AdjustSkills returns the new skill level distribution for all provided
+// players based on game configuration and draw status.
+func (ts Config) AdjustSkills(players Players, draw bool) (Players, float64) {
+	teams := make([]Team, players.Len())
+	for i, p := range players {
+		teams[i] = Team{p}
+	}
+
+	ranks := make([]int, len(teams))
+	for i := range ranks {
+		if draw {
+			// A shared rank means every team is tied with its neighbours.
+			ranks[i] = 0
+		} else {
+			ranks[i] = i
+		}
+	}
+
+	updated, probability := ts.AdjustTeamSkills(teams, ranks)
+
+	newPlayerSkills := Players{}
+	for _, team := range updated {
+		newPlayerSkills = append(newPlayerSkills, team...)
+	}
+
+	return newPlayerSkills, probability
+}
+
+// sortTeamsByRank returns the indices of ranks in ascending (best-first)
+// order, using a stable insertion sort so ties preserve the caller's
+// ordering.
+func sortTeamsByRank(ranks []int) []int {
+	order := make([]int, len(ranks))
+	for i := range order {
+		order[i] = i
+	}
+
+	for i := 1; i < len(order); i++ {
+		for j := i; j > 0 && ranks[order[j]] < ranks[order[j-1]]; j-- {
+			order[j], order[j-1] = order[j-1], order[j]
+		}
+	}
+
+	return order
+}
+
+// teamPerformance is the Gaussian belief over a team's combined, weighted
+// performance in a single match.
+type teamPerformance struct {
+	mu float64
+	v  float64 // variance
+}
+
+// adjustTeamSkills builds the sum-performance/difference/truncate factor
+// chain between the teams (sorted best-to-worst, with one draw flag per
+// adjacent boundary) and iterates it to convergence.
+//
+// Each team's performance prior is computed once, up front, from the
+// players' original ratings and never recomputed from a posterior — doing
+// so would condition on the same match evidence again on every sweep. A
+// team's current belief (marginal) instead accumulates one message per
+// adjacent edge it touches, and every edge update works off its own
+// cavity (marginal with that edge's previous message divided back out),
+// the standard expectation-propagation recipe for a chain of factors. This
+// makes a single edge (the common 1v1/2-team case) converge in exactly one
+// sweep, matching the closed-form Rate1vs1 update, while longer chains
+// refine without double-counting evidence.
+//
+// scores, when non-nil, holds one raw score per team (same order as teams)
+// and biases the truncate margin between adjacent teams towards a
+// continuous outcome instead of a pure win/draw/loss (see AdjustOptions).
+func adjustTeamSkills(ts Config, teams []Team, draws []bool, weights [][]float64, scores []float64) ([]Team, float64) {
+	n := len(teams)
+
+	prior := make([]teamPerformance, n)
+	for i, team := range teams {
+		prior[i] = teamPerformanceOf(ts, team, weights[i])
+	}
+
+	marginal := make([]gaussianNatural, n)
+	for i, p := range prior {
+		marginal[i] = naturalFromMeanVar(p.mu, p.v)
+	}
+
+	msgToLeft := make([]gaussianNatural, n-1)  // edge i's message into team i
+	msgToRight := make([]gaussianNatural, n-1) // edge i's message into team i+1
+
+	logZ := 0.0
+	for iter := 0; iter < teamSkillMaxIterations; iter++ {
+		prevMeans := make([]float64, n)
+		for i, m := range marginal {
+			prevMeans[i], _ = m.meanVar()
+		}
+
+		logZ = 0.0
+		for i := 0; i < n-1; i++ {
+			cavityA := marginal[i].sub(msgToLeft[i])
+			cavityB := marginal[i+1].sub(msgToRight[i])
+
+			muA, vA := cavityA.meanVar()
+			muB, vB := cavityB.meanVar()
+
+			c := math.Sqrt(vA + vB)
+			t := (muA - muB) / c
+			size := float64(len(teams[i]) + len(teams[i+1]))
+			baseMargin := ts.CalcDrawMargin(ts.DrawProb, size)
+			if scores != nil {
+				baseMargin *= scoreMarginScale(scores[i], scores[i+1])
+			}
+			margin := baseMargin / c
+
+			var v, w float64
+			if draws[i] {
+				v = vDraw(t, margin)
+				w = wDraw(t, margin)
+			} else {
+				v = vWin(t, margin)
+				w = wWin(t, margin)
+			}
+
+			newA := naturalFromMeanVar(muA+(vA/c)*v, vA*(1-(vA/(c*c))*w))
+			newB := naturalFromMeanVar(muB-(vB/c)*v, vB*(1-(vB/(c*c))*w))
+
+			msgToLeft[i] = newA.sub(cavityA)
+			msgToRight[i] = newB.sub(cavityB)
+
+			marginal[i] = newA
+			marginal[i+1] = newB
+
+			logZ += logDrawNormalization(t, margin, draws[i])
+		}
+
+		maxDelta := 0.0
+		for i, m := range marginal {
+			mean, _ := m.meanVar()
+			if d := math.Abs(mean - prevMeans[i]); d > maxDelta {
+				maxDelta = d
+			}
+		}
+		if maxDelta < loopMaxDelta {
+			break
+		}
+	}
+
+	result := make([]Team, n)
+	for i, team := range teams {
+		mu, v := marginal[i].meanVar()
+		result[i] = distributeTeamUpdate(team, weights[i], prior[i], teamPerformance{mu, v})
+	}
+
+	return result, math.Exp(logZ)
+}
+
+// gaussianNatural is a Gaussian in natural (precision) parameters, which
+// turn combining/removing independent Gaussian messages into simple
+// addition/subtraction: pi = 1/variance, tau = mean/variance.
+type gaussianNatural struct {
+	pi, tau float64
+}
+
+func naturalFromMeanVar(mu, v float64) gaussianNatural {
+	return gaussianNatural{pi: 1 / v, tau: mu / v}
+}
+
+func (g gaussianNatural) meanVar() (mu, v float64) {
+	if g.pi == 0 {
+		return 0, math.Inf(1)
+	}
+	v = 1 / g.pi
+	mu = g.tau / g.pi
+	return mu, v
+}
+
+func (g gaussianNatural) sub(o gaussianNatural) gaussianNatural {
+	return gaussianNatural{pi: g.pi - o.pi, tau: g.tau - o.tau}
+}
+
+// teamPerformanceOf sums a team's weighted player performances (skill plus
+// performance noise Beta^2) into a single Gaussian.
+func teamPerformanceOf(ts Config, team Players, weight []float64) teamPerformance {
+	var perf teamPerformance
+	for i, p := range team {
+		w := 1.0
+		if i < len(weight) {
+			w = weight[i]
+		}
+		perf.mu += w * p.Mu()
+		perf.v += w * w * (p.Sigma()*p.Sigma() + ts.Beta*ts.Beta)
+	}
+	return perf
+}
+
+// distributeTeamUpdate propagates a team performance update back to the
+// individual players, weighting each player's share of the adjustment by
+// its contribution to the team's performance variance.
+func distributeTeamUpdate(team Players, weight []float64, before, after teamPerformance) Players {
+	if before.v == 0 {
+		return team
+	}
+
+	updated := make(Players, len(team))
+	for i, p := range team {
+		w := 1.0
+		if i < len(weight) {
+			w = weight[i]
+		}
+		playerV := p.Sigma() * p.Sigma()
+
+		newMu := p.Mu() + (w*playerV/before.v)*(after.mu-before.mu)
+		newV := playerV * (1 - (w*w*playerV/(before.v*before.v))*(before.v-after.v))
+		if newV <= 0 {
+			newV = playerV
+		}
+
+		updated[i] = Player{Gaussian: gaussian.NewFromMeanAndVariance(newMu, newV)}
+	}
+
+	return updated
+}
+
+func logDrawNormalization(t, margin float64, draw bool) float64 {
+	if draw {
+		return math.Log(gaussian.NormCdf(margin-t) - gaussian.NormCdf(-margin-t))
+	}
+	return math.Log(gaussian.NormCdf(t - margin))
+}
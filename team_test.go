@@ -0,0 +1,104 @@
+package trueskill
+
+import (
+	"math"
+	"testing"
+)
+
+// TestAdjustSkillsMatchesRate1vs1 guards against adjustTeamSkills
+// double-counting match evidence across its relaxation iterations: for a
+// plain two-player match, a single pairwise factor update should produce
+// exactly the same result as the closed-form Rate1vs1 fast path, regardless
+// of how many sweeps the general chain takes to settle.
+func TestAdjustSkillsMatchesRate1vs1(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	for _, draw := range []bool{false, true} {
+		winner := ts.NewDefaultPlayer()
+		loser := ts.NewDefaultPlayer()
+
+		wantWinner, wantLoser := ts.Rate1vs1(winner, loser, draw)
+
+		got, _ := ts.AdjustSkills(Players{winner, loser}, draw)
+		if len(got) != 2 {
+			t.Fatalf("draw=%v: AdjustSkills returned %d players, want 2", draw, len(got))
+		}
+
+		gotWinner, gotLoser := got[0], got[1]
+		if !almostEqual(gotWinner.Mu(), wantWinner.Mu()) || !almostEqual(gotWinner.Sigma(), wantWinner.Sigma()) {
+			t.Errorf("draw=%v: winner = mu=%v sigma=%v, want mu=%v sigma=%v",
+				draw, gotWinner.Mu(), gotWinner.Sigma(), wantWinner.Mu(), wantWinner.Sigma())
+		}
+		if !almostEqual(gotLoser.Mu(), wantLoser.Mu()) || !almostEqual(gotLoser.Sigma(), wantLoser.Sigma()) {
+			t.Errorf("draw=%v: loser = mu=%v sigma=%v, want mu=%v sigma=%v",
+				draw, gotLoser.Mu(), gotLoser.Sigma(), wantLoser.Mu(), wantLoser.Sigma())
+		}
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+// TestAdjustTeamSkillsMultiTeamChain exercises the multi-edge EP chain in
+// adjustTeamSkills directly: 4 teams of varying size, with a tie in the
+// middle of the ranking rather than at the match's only boundary, so more
+// than one edge's cavity/message bookkeeping actually runs.
+func TestAdjustTeamSkillsMultiTeamChain(t *testing.T) {
+	ts, err := NewDefault(DefaultDrawProbPercentage)
+	if err != nil {
+		t.Fatalf("NewDefault: %v", err)
+	}
+
+	winner := Team{ts.NewDefaultPlayer()}
+	midA := Team{ts.NewDefaultPlayer(), ts.NewDefaultPlayer()}
+	midB := Team{ts.NewDefaultPlayer()}
+	loser := Team{ts.NewDefaultPlayer(), ts.NewDefaultPlayer(), ts.NewDefaultPlayer()}
+
+	teams := []Team{winner, midA, midB, loser}
+	ranks := []int{0, 1, 1, 3} // midA and midB are tied for 2nd/3rd place
+
+	updated, prob := ts.AdjustTeamSkills(teams, ranks)
+	if prob <= 0 {
+		t.Fatalf("AdjustTeamSkills returned non-positive probability %v", prob)
+	}
+	if len(updated) != 4 {
+		t.Fatalf("AdjustTeamSkills returned %d teams, want 4", len(updated))
+	}
+
+	startMu := ts.Mu
+	for i, team := range updated {
+		for j, p := range team {
+			if p.Sigma() >= ts.Sigma {
+				t.Errorf("team %d player %d: sigma = %v, want less than starting sigma %v", i, j, p.Sigma(), ts.Sigma)
+			}
+		}
+	}
+
+	// The outright winner must gain and the outright loser must drop,
+	// relative to their shared starting mu.
+	for _, p := range updated[0] {
+		if p.Mu() <= startMu {
+			t.Errorf("winner mu = %v, want greater than starting mu %v", p.Mu(), startMu)
+		}
+	}
+	for _, p := range updated[3] {
+		if p.Mu() >= startMu {
+			t.Errorf("loser mu = %v, want less than starting mu %v", p.Mu(), startMu)
+		}
+	}
+
+	// The tied middle teams must land strictly between the winner and the
+	// loser: they beat the loser but were beaten by the winner.
+	for _, mid := range [][]Player{updated[1], updated[2]} {
+		for _, p := range mid {
+			if !(p.Mu() < updated[0][0].Mu() && p.Mu() > updated[3][0].Mu()) {
+				t.Errorf("mid-table mu = %v, want strictly between loser mu %v and winner mu %v",
+					p.Mu(), updated[3][0].Mu(), updated[0][0].Mu())
+			}
+		}
+	}
+}
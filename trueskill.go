@@ -7,8 +7,6 @@ import (
 	"math"
 
 	"github.com/mafredri/go-gaussian"
-	"github.com/mafredri/go-trueskill/collection"
-	"github.com/mafredri/go-trueskill/schedule"
 )
 
 // Constants for the TrueSkill ranking system.
@@ -49,39 +47,6 @@ func NewDefault(drawProbPercentage float64) (Config, error) {
 	return New(DefaultMu, DefaultSigma, DefaultBeta, DefaultTau, drawProbPercentage)
 }
 
-// AdjustSkills returns the new skill level distribution for all provided
-// players based on game configuration and draw status.
-func (ts Config) AdjustSkills(players Players, draw bool) (Players, float64) {
-	// Sort players
-	// sort.Sort(players)
-
-	draws := []bool{}
-	for i := 0; i < players.Len()-1; i++ {
-		draws = append(draws, draw)
-	}
-
-	// TODO: Rewrite the distribution bag and simplify the factor list as well
-	prior := gaussian.NewFromPrecision(0, 0)
-	varBag := collection.NewDistributionBag(prior)
-
-	skillFactors, skillIndex, factorList := buildSkillFactors(ts, players, draws, varBag)
-
-	sched := buildSkillFactorSchedule(players.Len(), skillFactors, loopMaxDelta)
-
-	// delta
-	_ = schedule.Run(sched, -1)
-
-	logZ := factorList.LogNormalization()
-	probability := math.Exp(logZ)
-
-	newPlayerSkills := Players{}
-	for _, id := range skillIndex {
-		newPlayerSkills = append(newPlayerSkills, Player{Gaussian: varBag.Get(id)})
-	}
-
-	return newPlayerSkills, probability
-}
-
 // MatchQuality returns a float representing the quality of the match-up
 // between players.
 //
@@ -118,12 +83,3 @@ func (ts Config) WinProbability(a, b Players) float64 {
 func (ts Config) NewDefaultPlayer() Player {
 	return NewPlayer(ts.Mu, ts.Sigma)
 }
-
-// TrueSkill returns the conservative TrueSkill of a player. The maximum
-// TrueSkill is two times mu, in the default configuration a value between
-// zero and fifty is returned.
-func (ts Config) TrueSkill(p Player) float64 {
-	trueSkill := p.Mu() - p.Sigma()*3
-
-	return math.Min(ts.Mu*2, math.Max(0, trueSkill))
-}
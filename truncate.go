@@ -0,0 +1,59 @@
+package trueskill
+
+import (
+	"math"
+
+	"github.com/mafredri/go-gaussian"
+)
+
+// vWin and wWin are the additive and multiplicative corrections applied by
+// the truncate factor when the outcome between two adjacent performances is
+// a win, i.e. the performances are known to differ by at least margin. See
+// Herbrich et al., "TrueSkill: A Bayesian Skill Rating System", section 4.1.
+func vWin(t, margin float64) float64 {
+	x := t - margin
+	denom := gaussian.NormCdf(x)
+	if denom < 2.222758749e-162 {
+		return -x
+	}
+	return gaussian.NormPdf(x) / denom
+}
+
+func wWin(t, margin float64) float64 {
+	x := t - margin
+	v := vWin(t, margin)
+	return v * (v + x)
+}
+
+// vDraw and wDraw are the equivalents of vWin/wWin for the case where the
+// outcome is a draw, i.e. the performances are known to differ by less than
+// margin.
+func vDraw(t, margin float64) float64 {
+	absT := math.Abs(t)
+	a, b := margin-absT, -margin-absT
+	denom := gaussian.NormCdf(a) - gaussian.NormCdf(b)
+	if denom < 2.222758749e-162 {
+		if t < 0 {
+			return -a
+		}
+		return a
+	}
+
+	v := (gaussian.NormPdf(b) - gaussian.NormPdf(a)) / denom
+	if t < 0 {
+		return -v
+	}
+	return v
+}
+
+func wDraw(t, margin float64) float64 {
+	absT := math.Abs(t)
+	a, b := margin-absT, -margin-absT
+	denom := gaussian.NormCdf(a) - gaussian.NormCdf(b)
+	if denom < 2.222758749e-162 {
+		return 1
+	}
+
+	v := vDraw(absT, margin)
+	return (v * v) + (a*gaussian.NormPdf(a)-b*gaussian.NormPdf(b))/denom
+}